@@ -0,0 +1,114 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/docker/docker/api/types/network"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+// endpointOptions holds the per-endpoint settings applied when attaching a
+// container to a network at runtime via Connect.
+type endpointOptions struct {
+	settings network.EndpointSettings
+}
+
+// EndpointOption configures the endpoint settings used by Connect.
+type EndpointOption func(*endpointOptions)
+
+// WithEndpointAliases sets the network-scoped aliases for the endpoint, used by
+// other containers on the same network for service discovery.
+func WithEndpointAliases(aliases ...string) EndpointOption {
+	return func(o *endpointOptions) {
+		o.settings.Aliases = aliases
+	}
+}
+
+// WithEndpointLinks sets the legacy container links for the endpoint.
+func WithEndpointLinks(links ...string) EndpointOption {
+	return func(o *endpointOptions) {
+		o.settings.Links = links
+	}
+}
+
+// WithEndpointDriverOpts sets the driver-specific options for the endpoint.
+func WithEndpointDriverOpts(opts map[string]string) EndpointOption {
+	return func(o *endpointOptions) {
+		o.settings.DriverOpts = opts
+	}
+}
+
+// ipamConfig lazily initialises the endpoint's IPAM config, so that the
+// WithIPv4Address, WithIPv6Address and WithLinkLocalIPs options can be combined
+// freely in any order.
+func (o *endpointOptions) ipamConfig() *network.EndpointIPAMConfig {
+	if o.settings.IPAMConfig == nil {
+		o.settings.IPAMConfig = &network.EndpointIPAMConfig{}
+	}
+	return o.settings.IPAMConfig
+}
+
+// WithIPv4Address requests a static IPv4 address for the endpoint. The network
+// must have a matching IPAM subnet configured, see WithIPAM.
+func WithIPv4Address(ip string) EndpointOption {
+	return func(o *endpointOptions) {
+		o.ipamConfig().IPv4Address = ip
+	}
+}
+
+// WithIPv6Address requests a static IPv6 address for the endpoint. The network
+// must have IPv6 enabled, see WithIPv6.
+func WithIPv6Address(ip string) EndpointOption {
+	return func(o *endpointOptions) {
+		o.ipamConfig().IPv6Address = ip
+	}
+}
+
+// WithLinkLocalIPs requests one or more link-local IP addresses for the endpoint.
+func WithLinkLocalIPs(ips ...string) EndpointOption {
+	return func(o *endpointOptions) {
+		o.ipamConfig().LinkLocalIPs = ips
+	}
+}
+
+// Connect attaches an already running container to nw, wrapping the Docker
+// NetworkConnect API. Unlike ContainerRequest.Networks, which only wires networks
+// at container creation time, Connect can be called at any point in a container's
+// lifetime, which is useful for simulating network partitions, attaching sidecars
+// to a container that is already running, or exercising multi-alias service
+// discovery.
+func Connect(ctx context.Context, nw *testcontainers.DockerNetwork, container testcontainers.Container, opts ...EndpointOption) error {
+	cli, err := testcontainers.NewDockerClientWithOpts(ctx)
+	if err != nil {
+		return fmt.Errorf("new docker client: %w", err)
+	}
+	defer cli.Close()
+
+	var o endpointOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if err := cli.NetworkConnect(ctx, nw.ID, container.GetContainerID(), &o.settings); err != nil {
+		return fmt.Errorf("network connect: %w", err)
+	}
+
+	return nil
+}
+
+// Disconnect detaches container from nw, wrapping the Docker NetworkDisconnect API.
+func Disconnect(ctx context.Context, nw *testcontainers.DockerNetwork, container testcontainers.Container) error {
+	cli, err := testcontainers.NewDockerClientWithOpts(ctx)
+	if err != nil {
+		return fmt.Errorf("new docker client: %w", err)
+	}
+	defer cli.Close()
+
+	if err := cli.NetworkDisconnect(ctx, nw.ID, container.GetContainerID(), false); err != nil {
+		return fmt.Errorf("network disconnect: %w", err)
+	}
+
+	return nil
+}
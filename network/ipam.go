@@ -0,0 +1,64 @@
+package network
+
+import (
+	"github.com/docker/docker/api/types/network"
+)
+
+// IPAMBuilder builds a network.IPAM configuration using a fluent API, so that
+// callers can create dual-stack or MACVLAN networks without importing
+// github.com/docker/docker/api/types/network directly.
+type IPAMBuilder struct {
+	ipam network.IPAM
+}
+
+// NewIPAMBuilder creates an empty IPAMBuilder, defaulting to the "default" IPAM driver.
+func NewIPAMBuilder() *IPAMBuilder {
+	return &IPAMBuilder{
+		ipam: network.IPAM{Driver: "default"},
+	}
+}
+
+// Driver sets the IPAM driver to use, e.g. "default" or a custom IPAM plugin name.
+func (b *IPAMBuilder) Driver(driver string) *IPAMBuilder {
+	b.ipam.Driver = driver
+	return b
+}
+
+// Subnet appends a subnet to the IPAM configuration, along with its gateway and,
+// optionally, the allocatable IP range within it. Pass an empty ipRange to allocate
+// from the whole subnet.
+func (b *IPAMBuilder) Subnet(cidr, gateway, ipRange string) *IPAMBuilder {
+	b.ipam.Config = append(b.ipam.Config, network.IPAMConfig{
+		Subnet:  cidr,
+		Gateway: gateway,
+		IPRange: ipRange,
+	})
+	return b
+}
+
+// AuxAddress reserves an auxiliary address, identified by name, on the most
+// recently added subnet. Call Subnet before AuxAddress.
+func (b *IPAMBuilder) AuxAddress(name, ip string) *IPAMBuilder {
+	last := len(b.ipam.Config) - 1
+	if last < 0 {
+		return b
+	}
+
+	if b.ipam.Config[last].AuxAddress == nil {
+		b.ipam.Config[last].AuxAddress = map[string]string{}
+	}
+	b.ipam.Config[last].AuxAddress[name] = ip
+
+	return b
+}
+
+// Options sets IPAM driver-specific options.
+func (b *IPAMBuilder) Options(options map[string]string) *IPAMBuilder {
+	b.ipam.Options = options
+	return b
+}
+
+// Build returns the built IPAM configuration, ready to be passed to WithIPAM.
+func (b *IPAMBuilder) Build() *network.IPAM {
+	return &b.ipam
+}
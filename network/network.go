@@ -0,0 +1,72 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+// Bridge is the name of the default bridge driver used by Docker to create networks.
+const Bridge = "bridge"
+
+// New creates a new Docker network with a random name, applying the given options.
+// The caller is responsible for removing the network, usually via a defer
+// to net.Remove(ctx).
+func New(ctx context.Context, opts ...NetworkCustomizer) (*testcontainers.DockerNetwork, error) {
+	provider, err := testcontainers.NewDockerProvider()
+	if err != nil {
+		return nil, fmt.Errorf("new docker provider: %w", err)
+	}
+	defer provider.Close()
+
+	nc := testcontainers.NetworkRequest{
+		Driver: Bridge,
+	}
+
+	for _, opt := range opts {
+		opt.Customize(&nc)
+	}
+
+	return provider.CreateNetwork(ctx, nc)
+}
+
+// WithNetwork reuses an already existing network, attaching the given aliases to the
+// container being created on that network. It's useful when the network has already
+// been created, e.g. by a previous call to New or WithNewNetwork.
+func WithNetwork(aliases []string, nw *testcontainers.DockerNetwork) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) error {
+		networkName := nw.Name
+
+		req.Networks = append(req.Networks, networkName)
+
+		if req.NetworkAliases == nil {
+			req.NetworkAliases = make(map[string][]string)
+		}
+		req.NetworkAliases[networkName] = aliases
+
+		return nil
+	}
+}
+
+// WithNewNetwork creates a new network with random name and customizers, and attaches
+// the container being created to it, using the given aliases.
+func WithNewNetwork(ctx context.Context, aliases []string, opts ...NetworkCustomizer) testcontainers.CustomizeRequestOption {
+	return func(req *testcontainers.GenericContainerRequest) error {
+		newNetwork, err := New(ctx, opts...)
+		if err != nil {
+			return fmt.Errorf("new network: %w", err)
+		}
+
+		networkName := newNetwork.Name
+
+		req.Networks = append(req.Networks, networkName)
+
+		if req.NetworkAliases == nil {
+			req.NetworkAliases = make(map[string][]string)
+		}
+		req.NetworkAliases[networkName] = aliases
+
+		return nil
+	}
+}
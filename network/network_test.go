@@ -2,6 +2,8 @@ package network_test
 
 import (
 	"context"
+	"errors"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/internal/core"
 	"github.com/testcontainers/testcontainers-go/network"
+	"github.com/testcontainers/testcontainers-go/network/remotedriver"
 	"github.com/testcontainers/testcontainers-go/wait"
 )
 
@@ -368,6 +371,151 @@ func TestNew_withOptions(t *testing.T) {
 	assert.Equal(t, ipamConfig, foundNetwork.IPAM)
 }
 
+func TestNew_withIPv6AndIPAMBuilder(t *testing.T) {
+	ctx := context.Background()
+
+	ipam := network.NewIPAMBuilder().
+		Subnet("2001:db8::/64", "2001:db8::1", "").
+		Build()
+
+	net, err := network.New(ctx,
+		network.WithIPv6(),
+		network.WithIPAM(ipam),
+		network.WithOptions(map[string]string{"com.docker.network.bridge.name": "tc-ipv6-br"}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, net.Remove(ctx))
+	})
+
+	client, err := testcontainers.NewDockerClientWithOpts(ctx)
+	require.NoError(t, err)
+	defer client.Close()
+
+	resources, err := client.NetworkList(ctx, dockernetwork.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("name", net.Name)),
+	})
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+
+	foundNetwork := resources[0]
+	assert.True(t, foundNetwork.EnableIPv6)
+	require.Len(t, foundNetwork.IPAM.Config, 1)
+	assert.Equal(t, "2001:db8::/64", foundNetwork.IPAM.Config[0].Subnet)
+	assert.Equal(t, "tc-ipv6-br", foundNetwork.Options["com.docker.network.bridge.name"])
+}
+
+func TestRemoteDriverIsInvokedByDaemon(t *testing.T) {
+	if core.IsWindows() {
+		t.Skip("remote driver plugins are registered via a Unix socket, not supported on Windows")
+	}
+
+	ctx := context.Background()
+
+	var created atomic.Bool
+
+	driverName, err := network.NewRemoteDriver(t,
+		network.WithCreateHook(func(req remotedriver.CreateNetworkRequest) error {
+			created.Store(true)
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+
+	nw, err := network.New(ctx, network.WithDriver(driverName))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, nw.Remove(ctx))
+	})
+
+	// the daemon only reaches NetworkDriver.CreateNetwork after a successful
+	// Plugin.Activate handshake, so observing this hook fire proves the whole
+	// registration path, socket discovery included, actually works end to end.
+	assert.True(t, created.Load())
+}
+
+func TestOverlayNetworkAttachedToStandaloneContainer(t *testing.T) {
+	if core.IsWindows() {
+		t.Skip("overlay networks require swarm mode, which is not supported on Windows")
+	}
+
+	ctx := context.Background()
+
+	if err := network.EnsureSwarm(ctx, t); err != nil {
+		if errors.Is(err, network.ErrSwarmUnsupported) {
+			t.Skip(err)
+		}
+		require.NoError(t, err)
+	}
+
+	nw, err := network.New(ctx, network.WithOverlayDriver())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, nw.Remove(ctx))
+	})
+
+	aliases := []string{"alias1", "alias2"}
+
+	nginx, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:          nginxAlpineImage,
+			Networks:       []string{nw.Name},
+			NetworkAliases: map[string][]string{nw.Name: aliases},
+		},
+		Started: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, nginx.Terminate(ctx))
+	})
+
+	networkAliases, err := nginx.NetworkAliases(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, aliases, networkAliases[nw.Name])
+}
+
+func TestSharedNetworkReferenceCounting(t *testing.T) {
+	ctx := context.Background()
+
+	key := "shared-network-test"
+
+	first, err := network.Shared(ctx, key, network.WithAttachable())
+	require.NoError(t, err)
+
+	second, err := network.Shared(ctx, key)
+	require.NoError(t, err)
+
+	assert.Equal(t, first.Network.Name, second.Network.Name)
+
+	client, err := testcontainers.NewDockerClientWithOpts(ctx)
+	require.NoError(t, err)
+	defer client.Close()
+
+	resources, err := client.NetworkList(ctx, dockernetwork.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("name", first.Network.Name)),
+	})
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+
+	// releasing one of the two references must not remove the network yet
+	require.NoError(t, first.Release(ctx))
+
+	resources, err = client.NetworkList(ctx, dockernetwork.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("name", second.Network.Name)),
+	})
+	require.NoError(t, err)
+	require.Len(t, resources, 1)
+
+	// releasing the last reference removes the network
+	require.NoError(t, second.Release(ctx))
+
+	resources, err = client.NetworkList(ctx, dockernetwork.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("name", second.Network.Name)),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resources)
+}
+
 func TestWithNetwork(t *testing.T) {
 	// first create the network to be reused
 	nw, err := network.New(context.Background(), network.WithLabels(map[string]string{"network-type": "unique"}))
@@ -516,3 +664,59 @@ func TestWithNewNetworkContextTimeout(t *testing.T) {
 	assert.Empty(t, req.Networks)
 	assert.Empty(t, req.NetworkAliases)
 }
+
+func TestConnectDisconnect(t *testing.T) {
+	ctx := context.Background()
+
+	nw, err := network.New(ctx, network.WithAttachable())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, nw.Remove(ctx))
+	})
+
+	nginx, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:        nginxAlpineImage,
+			ExposedPorts: []string{nginxDefaultPort},
+			WaitingFor:   wait.ForListeningPort(nginxDefaultPort),
+		},
+		Started: true,
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, nginx.Terminate(ctx))
+	})
+
+	aliases := []string{"alias1", "alias2"}
+
+	err = network.Connect(ctx, nw, nginx,
+		network.WithEndpointAliases(aliases...),
+	)
+	require.NoError(t, err)
+
+	client, err := testcontainers.NewDockerClientWithOpts(ctx)
+	require.NoError(t, err)
+	defer client.Close()
+
+	inspect, err := client.ContainerInspect(ctx, nginx.GetContainerID())
+	require.NoError(t, err)
+
+	settings, ok := inspect.NetworkSettings.Networks[nw.Name]
+	require.True(t, ok)
+	assert.ElementsMatch(t, aliases, settings.Aliases)
+
+	err = network.Disconnect(ctx, nw, nginx)
+	require.NoError(t, err)
+
+	inspect, err = client.ContainerInspect(ctx, nginx.GetContainerID())
+	require.NoError(t, err)
+	assert.NotContains(t, inspect.NetworkSettings.Networks, nw.Name)
+
+	// reconnect to verify the container can rejoin the network later in its lifetime
+	err = network.Connect(ctx, nw, nginx)
+	require.NoError(t, err)
+
+	inspect, err = client.ContainerInspect(ctx, nginx.GetContainerID())
+	require.NoError(t, err)
+	assert.Contains(t, inspect.NetworkSettings.Networks, nw.Name)
+}
@@ -0,0 +1,112 @@
+package network
+
+import (
+	"github.com/docker/docker/api/types/network"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+// NetworkCustomizer is an interface that can be used to configure the network request
+// used to create a network via New.
+type NetworkCustomizer interface {
+	Customize(req *testcontainers.NetworkRequest)
+}
+
+// CustomizeNetworkOption is a type that can be used to configure the network request
+// used to create a network via New. It implements the NetworkCustomizer interface,
+// allowing plain functions to be passed as options.
+type CustomizeNetworkOption func(req *testcontainers.NetworkRequest)
+
+// Customize implements the NetworkCustomizer interface.
+func (opt CustomizeNetworkOption) Customize(req *testcontainers.NetworkRequest) {
+	opt(req)
+}
+
+// WithAttachable allows the network to be attached to containers after it has been created.
+func WithAttachable() CustomizeNetworkOption {
+	return func(req *testcontainers.NetworkRequest) {
+		req.Attachable = true
+	}
+}
+
+// WithInternal makes the network internal, meaning that the host machine cannot access it,
+// and it's only reachable from within the network itself.
+func WithInternal() CustomizeNetworkOption {
+	return func(req *testcontainers.NetworkRequest) {
+		req.Internal = true
+	}
+}
+
+// WithDriver sets the driver to be used for the network, e.g. "bridge" or "overlay".
+// If not set, the network is created using the default bridge driver.
+func WithDriver(driver string) CustomizeNetworkOption {
+	return func(req *testcontainers.NetworkRequest) {
+		req.Driver = driver
+	}
+}
+
+// WithLabels sets the labels to be used for the network.
+func WithLabels(labels map[string]string) CustomizeNetworkOption {
+	return func(req *testcontainers.NetworkRequest) {
+		req.Labels = labels
+	}
+}
+
+// WithIPAM sets the IPAM configuration to be used for the network.
+func WithIPAM(ipam *network.IPAM) CustomizeNetworkOption {
+	return func(req *testcontainers.NetworkRequest) {
+		req.IPAM = ipam
+	}
+}
+
+// WithIPv6 enables IPv6 on the network, equivalent to the `--ipv6` flag of
+// `docker network create`.
+func WithIPv6() CustomizeNetworkOption {
+	return func(req *testcontainers.NetworkRequest) {
+		enableIPv6 := true
+		req.EnableIPv6 = &enableIPv6
+	}
+}
+
+// WithIngress marks the network as the ingress network for a swarm, used to
+// route traffic arriving on a published port to a service task, regardless of
+// which node it's currently scheduled on. Only one ingress network can exist
+// in a swarm at a time.
+func WithIngress() CustomizeNetworkOption {
+	return func(req *testcontainers.NetworkRequest) {
+		req.Ingress = true
+	}
+}
+
+// WithScope sets the scope of the network, e.g. "local" or "swarm".
+func WithScope(scope string) CustomizeNetworkOption {
+	return func(req *testcontainers.NetworkRequest) {
+		req.Scope = scope
+	}
+}
+
+// WithConfigFrom names a config-only network to create this network's IPAM
+// and options from, instead of specifying them directly. See WithConfigOnly
+// to create the config-only network itself.
+func WithConfigFrom(network string) CustomizeNetworkOption {
+	return func(req *testcontainers.NetworkRequest) {
+		req.ConfigFrom = network
+	}
+}
+
+// WithConfigOnly marks the network as a config-only network: one with no
+// container endpoints, used only to share configuration with other networks
+// via WithConfigFrom.
+func WithConfigOnly() CustomizeNetworkOption {
+	return func(req *testcontainers.NetworkRequest) {
+		req.ConfigOnly = true
+	}
+}
+
+// WithOptions sets driver-specific options for the network, e.g.
+// com.docker.network.bridge.name for the bridge driver.
+func WithOptions(options map[string]string) CustomizeNetworkOption {
+	return func(req *testcontainers.NetworkRequest) {
+		req.Options = options
+	}
+}
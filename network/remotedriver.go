@@ -0,0 +1,25 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/testcontainers/testcontainers-go/network/remotedriver"
+)
+
+// NewRemoteDriver starts an in-process libnetwork remote driver and IPAM plugin
+// server for use in tests, registering it with the Docker daemon so it can be
+// referenced from WithDriver. It returns the generated driver name.
+func NewRemoteDriver(t testing.TB, opts ...remotedriver.Option) (string, error) {
+	return remotedriver.New(t, opts...)
+}
+
+// WithCreateHook invokes fn whenever the daemon asks the driver to create a network.
+func WithCreateHook(fn func(remotedriver.CreateNetworkRequest) error) remotedriver.Option {
+	return remotedriver.WithCreateHook(fn)
+}
+
+// WithIPAMRequestPool invokes fn whenever the daemon requests an address pool from
+// the driver's IPAM plugin.
+func WithIPAMRequestPool(fn func(remotedriver.RequestPoolRequest) (*remotedriver.RequestPoolResponse, error)) remotedriver.Option {
+	return remotedriver.WithIPAMRequestPool(fn)
+}
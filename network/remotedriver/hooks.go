@@ -0,0 +1,88 @@
+package remotedriver
+
+// hooks holds the callbacks a test can register to observe, or fail, each step
+// of the libnetwork remote driver and IPAM plugin protocols.
+type hooks struct {
+	createNetwork      func(CreateNetworkRequest) error
+	deleteNetwork      func(DeleteNetworkRequest) error
+	createEndpoint     func(CreateEndpointRequest) (*CreateEndpointResponse, error)
+	join               func(JoinRequest) (*JoinResponse, error)
+	leave              func(LeaveRequest) error
+	ipamRequestPool    func(RequestPoolRequest) (*RequestPoolResponse, error)
+	ipamReleasePool    func(ReleasePoolRequest) error
+	ipamRequestAddress func(RequestAddressRequest) (*RequestAddressResponse, error)
+	ipamReleaseAddress func(ReleaseAddressRequest) error
+}
+
+// Option configures the hooks invoked by a Driver created with New.
+type Option func(*hooks)
+
+// WithCreateHook invokes fn whenever the daemon asks the driver to create a network.
+// Returning an error fails the NetworkDriver.CreateNetwork call.
+func WithCreateHook(fn func(CreateNetworkRequest) error) Option {
+	return func(h *hooks) {
+		h.createNetwork = fn
+	}
+}
+
+// WithDeleteHook invokes fn whenever the daemon asks the driver to delete a network.
+func WithDeleteHook(fn func(DeleteNetworkRequest) error) Option {
+	return func(h *hooks) {
+		h.deleteNetwork = fn
+	}
+}
+
+// WithCreateEndpointHook invokes fn whenever the daemon asks the driver to create
+// an endpoint, letting the test control the interface handed back to the daemon.
+func WithCreateEndpointHook(fn func(CreateEndpointRequest) (*CreateEndpointResponse, error)) Option {
+	return func(h *hooks) {
+		h.createEndpoint = fn
+	}
+}
+
+// WithJoinHook invokes fn whenever the daemon asks the driver to join an endpoint
+// into a sandbox.
+func WithJoinHook(fn func(JoinRequest) (*JoinResponse, error)) Option {
+	return func(h *hooks) {
+		h.join = fn
+	}
+}
+
+// WithLeaveHook invokes fn whenever the daemon asks the driver to leave an endpoint.
+func WithLeaveHook(fn func(LeaveRequest) error) Option {
+	return func(h *hooks) {
+		h.leave = fn
+	}
+}
+
+// WithIPAMRequestPool invokes fn whenever the daemon requests an address pool
+// from the driver's IPAM plugin.
+func WithIPAMRequestPool(fn func(RequestPoolRequest) (*RequestPoolResponse, error)) Option {
+	return func(h *hooks) {
+		h.ipamRequestPool = fn
+	}
+}
+
+// WithIPAMReleasePool invokes fn whenever the daemon releases a previously
+// requested address pool.
+func WithIPAMReleasePool(fn func(ReleasePoolRequest) error) Option {
+	return func(h *hooks) {
+		h.ipamReleasePool = fn
+	}
+}
+
+// WithIPAMRequestAddress invokes fn whenever the daemon requests an address from
+// a pool owned by the driver's IPAM plugin.
+func WithIPAMRequestAddress(fn func(RequestAddressRequest) (*RequestAddressResponse, error)) Option {
+	return func(h *hooks) {
+		h.ipamRequestAddress = fn
+	}
+}
+
+// WithIPAMReleaseAddress invokes fn whenever the daemon releases a previously
+// requested address.
+func WithIPAMReleaseAddress(fn func(ReleaseAddressRequest) error) Option {
+	return func(h *hooks) {
+		h.ipamReleaseAddress = fn
+	}
+}
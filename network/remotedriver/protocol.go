@@ -0,0 +1,132 @@
+package remotedriver
+
+// activateResponse is returned from Plugin.Activate, telling the Docker daemon
+// which plugin interfaces this driver implements.
+type activateResponse struct {
+	Implements []string
+}
+
+// IPAMData describes one of the address pools assigned to a network, as sent
+// to NetworkDriver.CreateNetwork.
+type IPAMData struct {
+	AddressSpace string
+	Pool         string
+	Gateway      string
+	AuxAddresses map[string]string
+}
+
+// CreateNetworkRequest is the body of NetworkDriver.CreateNetwork.
+type CreateNetworkRequest struct {
+	NetworkID string
+	Options   map[string]interface{}
+	IPv4Data  []IPAMData
+	IPv6Data  []IPAMData
+}
+
+// DeleteNetworkRequest is the body of NetworkDriver.DeleteNetwork.
+type DeleteNetworkRequest struct {
+	NetworkID string
+}
+
+// EndpointInterface describes the interface assigned to an endpoint, either
+// requested by the daemon or returned by the driver.
+type EndpointInterface struct {
+	Address     string
+	AddressIPv6 string
+	MacAddress  string
+}
+
+// CreateEndpointRequest is the body of NetworkDriver.CreateEndpoint.
+type CreateEndpointRequest struct {
+	NetworkID  string
+	EndpointID string
+	Interface  *EndpointInterface
+	Options    map[string]interface{}
+}
+
+// CreateEndpointResponse is the response to NetworkDriver.CreateEndpoint.
+type CreateEndpointResponse struct {
+	Interface *EndpointInterface
+}
+
+// JoinRequest is the body of NetworkDriver.Join.
+type JoinRequest struct {
+	NetworkID  string
+	EndpointID string
+	SandboxKey string
+	Options    map[string]interface{}
+}
+
+// InterfaceName tells the daemon how to rename the interface moved into the
+// container's network namespace.
+type InterfaceName struct {
+	SrcName   string
+	DstPrefix string
+}
+
+// StaticRoute is a route the daemon should install in the container's sandbox.
+type StaticRoute struct {
+	Destination string
+	RouteType   int
+	NextHop     string
+}
+
+// JoinResponse is the response to NetworkDriver.Join.
+type JoinResponse struct {
+	InterfaceName *InterfaceName
+	Gateway       string
+	GatewayIPv6   string
+	StaticRoutes  []StaticRoute
+}
+
+// LeaveRequest is the body of NetworkDriver.Leave.
+type LeaveRequest struct {
+	NetworkID  string
+	EndpointID string
+}
+
+// GetDefaultAddressSpacesResponse is the response to IpamDriver.GetDefaultAddressSpaces.
+type GetDefaultAddressSpacesResponse struct {
+	LocalDefaultAddressSpace  string
+	GlobalDefaultAddressSpace string
+}
+
+// RequestPoolRequest is the body of IpamDriver.RequestPool.
+type RequestPoolRequest struct {
+	AddressSpace string
+	Pool         string
+	SubPool      string
+	Options      map[string]string
+	V6           bool
+}
+
+// RequestPoolResponse is the response to IpamDriver.RequestPool.
+type RequestPoolResponse struct {
+	PoolID string
+	Pool   string
+	Data   map[string]string
+}
+
+// ReleasePoolRequest is the body of IpamDriver.ReleasePool.
+type ReleasePoolRequest struct {
+	PoolID string
+}
+
+// RequestAddressRequest is the body of IpamDriver.RequestAddress.
+type RequestAddressRequest struct {
+	PoolID  string
+	Address string
+	Options map[string]string
+}
+
+// RequestAddressResponse is the response to IpamDriver.RequestAddress.
+type RequestAddressResponse struct {
+	Address string
+	Data    map[string]string
+}
+
+// ReleaseAddressRequest is the body of IpamDriver.ReleaseAddress.
+type ReleaseAddressRequest struct {
+	PoolID  string
+	Address string
+}
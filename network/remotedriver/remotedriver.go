@@ -0,0 +1,303 @@
+// Package remotedriver spins up an in-process HTTP server implementing the
+// libnetwork remote driver and IPAM plugin protocols, and registers it with the
+// Docker daemon via a Unix socket plugin spec. It mirrors the dummyNetworkDriver
+// and dummyIpamDriver pattern used in moby's own integration tests, letting
+// downstream modules verify their containers behave correctly against custom
+// CNM drivers without needing an external plugin binary.
+package remotedriver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// pluginDirs lists the directories the Docker daemon scans for Unix socket
+// plugin specs, in the order they are tried. A plugin is discovered by dropping
+// a <name>.sock listener in one of these directories.
+var pluginDirs = []string{
+	"/etc/docker/plugins",
+	"/run/docker/plugins",
+}
+
+// Driver is an in-process libnetwork remote driver and IPAM plugin server.
+type Driver struct {
+	// Name is the driver name, as registered with the Docker daemon. Pass it to
+	// network.WithDriver to create networks backed by this driver.
+	Name string
+
+	hooks    hooks
+	listener net.Listener
+	server   *http.Server
+}
+
+// New starts a Driver and registers it with the Docker daemon, returning its
+// generated name. The server, its listener, and the plugin socket file are
+// cleaned up automatically via t.Cleanup.
+func New(t testing.TB, opts ...Option) (string, error) {
+	t.Helper()
+
+	name := "tc-remote-" + uuid.New().String()
+
+	var h hooks
+	for _, opt := range opts {
+		opt(&h)
+	}
+
+	socketPath, listener, err := listenPluginSocket(name)
+	if err != nil {
+		return "", fmt.Errorf("listen for plugin socket: %w", err)
+	}
+
+	d := &Driver{
+		Name:     name,
+		hooks:    h,
+		listener: listener,
+		server:   &http.Server{Handler: newMux(&h)},
+	}
+
+	go func() {
+		_ = d.server.Serve(listener)
+	}()
+
+	t.Cleanup(func() {
+		_ = d.server.Close()
+		_ = os.Remove(socketPath)
+	})
+
+	return name, nil
+}
+
+// listenPluginSocket tries each directory in pluginDirs in turn, returning a
+// listener on the first one that is actually writable by the current user.
+// MkdirAll succeeding isn't enough to prove that: it's a no-op on a directory
+// that already exists, even if it's root-owned and unwritable by us, so the
+// real writability check is attempting to create the socket file itself via
+// net.Listen and falling through to the next directory if that fails.
+func listenPluginSocket(name string) (string, net.Listener, error) {
+	var lastErr error
+	for _, dir := range pluginDirs {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			lastErr = err
+			continue
+		}
+
+		socketPath := filepath.Join(dir, name+".sock")
+
+		listener, err := net.Listen("unix", socketPath)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		return socketPath, listener, nil
+	}
+
+	return "", nil, fmt.Errorf("no writable docker plugin directory found, tried %v: %w", pluginDirs, lastErr)
+}
+
+func newMux(h *hooks) *http.ServeMux {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/Plugin.Activate", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, activateResponse{Implements: []string{"NetworkDriver", "IpamDriver"}})
+	})
+
+	mux.HandleFunc("/NetworkDriver.GetCapabilities", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, struct{ Scope string }{Scope: "local"})
+	})
+
+	mux.HandleFunc("/NetworkDriver.CreateNetwork", func(w http.ResponseWriter, r *http.Request) {
+		var req CreateNetworkRequest
+		if !decodeJSON(w, r, &req) {
+			return
+		}
+
+		if h.createNetwork != nil {
+			if err := h.createNetwork(req); err != nil {
+				writeError(w, err)
+				return
+			}
+		}
+
+		writeJSON(w, struct{}{})
+	})
+
+	mux.HandleFunc("/NetworkDriver.DeleteNetwork", func(w http.ResponseWriter, r *http.Request) {
+		var req DeleteNetworkRequest
+		if !decodeJSON(w, r, &req) {
+			return
+		}
+
+		if h.deleteNetwork != nil {
+			if err := h.deleteNetwork(req); err != nil {
+				writeError(w, err)
+				return
+			}
+		}
+
+		writeJSON(w, struct{}{})
+	})
+
+	mux.HandleFunc("/NetworkDriver.CreateEndpoint", func(w http.ResponseWriter, r *http.Request) {
+		var req CreateEndpointRequest
+		if !decodeJSON(w, r, &req) {
+			return
+		}
+
+		resp := &CreateEndpointResponse{Interface: req.Interface}
+		if h.createEndpoint != nil {
+			hookResp, err := h.createEndpoint(req)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			resp = hookResp
+		}
+
+		writeJSON(w, resp)
+	})
+
+	mux.HandleFunc("/NetworkDriver.Join", func(w http.ResponseWriter, r *http.Request) {
+		var req JoinRequest
+		if !decodeJSON(w, r, &req) {
+			return
+		}
+
+		resp := &JoinResponse{}
+		if h.join != nil {
+			hookResp, err := h.join(req)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			resp = hookResp
+		}
+
+		writeJSON(w, resp)
+	})
+
+	mux.HandleFunc("/NetworkDriver.Leave", func(w http.ResponseWriter, r *http.Request) {
+		var req LeaveRequest
+		if !decodeJSON(w, r, &req) {
+			return
+		}
+
+		if h.leave != nil {
+			if err := h.leave(req); err != nil {
+				writeError(w, err)
+				return
+			}
+		}
+
+		writeJSON(w, struct{}{})
+	})
+
+	mux.HandleFunc("/IpamDriver.GetDefaultAddressSpaces", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, GetDefaultAddressSpacesResponse{
+			LocalDefaultAddressSpace:  "tc-local",
+			GlobalDefaultAddressSpace: "tc-global",
+		})
+	})
+
+	mux.HandleFunc("/IpamDriver.RequestPool", func(w http.ResponseWriter, r *http.Request) {
+		var req RequestPoolRequest
+		if !decodeJSON(w, r, &req) {
+			return
+		}
+
+		if h.ipamRequestPool == nil {
+			writeError(w, fmt.Errorf("no RequestPool hook registered"))
+			return
+		}
+
+		resp, err := h.ipamRequestPool(req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		writeJSON(w, resp)
+	})
+
+	mux.HandleFunc("/IpamDriver.ReleasePool", func(w http.ResponseWriter, r *http.Request) {
+		var req ReleasePoolRequest
+		if !decodeJSON(w, r, &req) {
+			return
+		}
+
+		if h.ipamReleasePool != nil {
+			if err := h.ipamReleasePool(req); err != nil {
+				writeError(w, err)
+				return
+			}
+		}
+
+		writeJSON(w, struct{}{})
+	})
+
+	mux.HandleFunc("/IpamDriver.RequestAddress", func(w http.ResponseWriter, r *http.Request) {
+		var req RequestAddressRequest
+		if !decodeJSON(w, r, &req) {
+			return
+		}
+
+		if h.ipamRequestAddress == nil {
+			writeError(w, fmt.Errorf("no RequestAddress hook registered"))
+			return
+		}
+
+		resp, err := h.ipamRequestAddress(req)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		writeJSON(w, resp)
+	})
+
+	mux.HandleFunc("/IpamDriver.ReleaseAddress", func(w http.ResponseWriter, r *http.Request) {
+		var req ReleaseAddressRequest
+		if !decodeJSON(w, r, &req) {
+			return
+		}
+
+		if h.ipamReleaseAddress != nil {
+			if err := h.ipamReleaseAddress(req); err != nil {
+				writeError(w, err)
+				return
+			}
+		}
+
+		writeJSON(w, struct{}{})
+	})
+
+	return mux
+}
+
+func decodeJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, fmt.Errorf("decode request: %w", err))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/vnd.docker.plugins.v1.2+json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError reports err back to the daemon in the shape it expects from a
+// failed plugin call: a 200 response with a non-empty "Err" field.
+func writeError(w http.ResponseWriter, err error) {
+	writeJSON(w, struct {
+		Err string
+	}{Err: err.Error()})
+}
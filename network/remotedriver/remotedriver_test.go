@@ -0,0 +1,99 @@
+package remotedriver
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func post(t *testing.T, srv *httptest.Server, path string, body interface{}, out interface{}) {
+	t.Helper()
+
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	resp, err := http.Post(srv.URL+path, "application/json", bytes.NewReader(payload))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(out))
+}
+
+func TestActivateAdvertisesBothInterfaces(t *testing.T) {
+	srv := httptest.NewServer(newMux(&hooks{}))
+	defer srv.Close()
+
+	var resp activateResponse
+	post(t, srv, "/Plugin.Activate", struct{}{}, &resp)
+
+	assert.ElementsMatch(t, []string{"NetworkDriver", "IpamDriver"}, resp.Implements)
+}
+
+func TestCreateNetworkHookIsInvoked(t *testing.T) {
+	var got CreateNetworkRequest
+	h := &hooks{
+		createNetwork: func(req CreateNetworkRequest) error {
+			got = req
+			return nil
+		},
+	}
+
+	srv := httptest.NewServer(newMux(h))
+	defer srv.Close()
+
+	req := CreateNetworkRequest{NetworkID: "net-1"}
+
+	var resp struct{ Err string }
+	post(t, srv, "/NetworkDriver.CreateNetwork", req, &resp)
+
+	assert.Empty(t, resp.Err)
+	assert.Equal(t, "net-1", got.NetworkID)
+}
+
+func TestCreateNetworkHookFailureIsSurfacedAsPluginError(t *testing.T) {
+	h := &hooks{
+		createNetwork: func(req CreateNetworkRequest) error {
+			return assert.AnError
+		},
+	}
+
+	srv := httptest.NewServer(newMux(h))
+	defer srv.Close()
+
+	var resp struct{ Err string }
+	post(t, srv, "/NetworkDriver.CreateNetwork", CreateNetworkRequest{NetworkID: "net-1"}, &resp)
+
+	assert.Equal(t, assert.AnError.Error(), resp.Err)
+}
+
+func TestIPAMRequestPoolWithoutHookFails(t *testing.T) {
+	srv := httptest.NewServer(newMux(&hooks{}))
+	defer srv.Close()
+
+	var resp struct{ Err string }
+	post(t, srv, "/IpamDriver.RequestPool", RequestPoolRequest{Pool: "10.1.2.0/24"}, &resp)
+
+	assert.NotEmpty(t, resp.Err)
+}
+
+func TestIPAMRequestPoolHookIsInvoked(t *testing.T) {
+	h := &hooks{
+		ipamRequestPool: func(req RequestPoolRequest) (*RequestPoolResponse, error) {
+			return &RequestPoolResponse{PoolID: "pool-1", Pool: req.Pool}, nil
+		},
+	}
+
+	srv := httptest.NewServer(newMux(h))
+	defer srv.Close()
+
+	var resp RequestPoolResponse
+	post(t, srv, "/IpamDriver.RequestPool", RequestPoolRequest{Pool: "10.1.2.0/24"}, &resp)
+
+	assert.Equal(t, "pool-1", resp.PoolID)
+	assert.Equal(t, "10.1.2.0/24", resp.Pool)
+}
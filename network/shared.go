@@ -0,0 +1,197 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	dockernetwork "github.com/docker/docker/api/types/network"
+	dockerclient "github.com/docker/docker/client"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+// sharedKeyLabel identifies the networks created by Shared. Its value is the
+// caller-supplied key. It is used to find an existing network, and is also set
+// on each reference container; Release counts references by the containers
+// attached to the network, not by this label.
+const sharedKeyLabel = "testcontainers.shared-key"
+
+// sharedRefImage is the image used for the lightweight sentinel container that
+// each Shared call attaches to the network to track its reference count.
+const sharedRefImage = "alpine:3.19"
+
+// SharedNetwork is a network obtained via Shared, reference-counted so that it
+// is only removed once every caller has called Release.
+//
+// Network is deliberately not embedded: a SharedNetwork obtained by reusing an
+// existing network (rather than creating one) carries a bare
+// *testcontainers.DockerNetwork with no provider wired up, so promoting its
+// Remove method would let callers bypass the reference count and panic on a
+// nil provider. Use Release instead.
+type SharedNetwork struct {
+	Network *testcontainers.DockerNetwork
+
+	ref testcontainers.Container
+}
+
+// Shared returns the network identified by key, creating it with opts if it
+// doesn't already exist, and incrementing its reference count. Networks are
+// identified by the testcontainers.shared-key label rather than by name, so
+// that independent `go test` packages, and even separate processes, can safely
+// share expensive networks such as overlays, MACVLAN, or custom-driver networks,
+// extending the in-process reuse of WithNetwork to cross-process reuse.
+//
+// Docker network labels can't be updated after creation, so the reference count
+// isn't stored as a label: it's tracked by attaching one lightweight sentinel
+// container per Shared call to the network, and counting how many are still
+// attached when Release is called.
+func Shared(ctx context.Context, key string, opts ...NetworkCustomizer) (*SharedNetwork, error) {
+	cli, err := testcontainers.NewDockerClientWithOpts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("new docker client: %w", err)
+	}
+	defer cli.Close()
+
+	resources, err := cli.NetworkList(ctx, dockernetwork.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", sharedKeyLabel+"="+key)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list networks: %w", err)
+	}
+
+	var nw *testcontainers.DockerNetwork
+	switch len(resources) {
+	case 0:
+		// Apply the shared-key label last, so that it can't be clobbered by a
+		// caller-supplied WithLabels option, which replaces req.Labels wholesale.
+		withSharedLabel := CustomizeNetworkOption(func(req *testcontainers.NetworkRequest) {
+			if req.Labels == nil {
+				req.Labels = map[string]string{}
+			}
+			req.Labels[sharedKeyLabel] = key
+		})
+
+		nw, err = New(ctx, append(append([]NetworkCustomizer{}, opts...), withSharedLabel)...)
+		if err != nil {
+			return nil, fmt.Errorf("create shared network: %w", err)
+		}
+
+		nw, err = resolveRace(ctx, cli, key, nw)
+		if err != nil {
+			return nil, err
+		}
+	case 1:
+		nw = &testcontainers.DockerNetwork{ID: resources[0].ID, Name: resources[0].Name}
+	default:
+		return nil, fmt.Errorf("shared: ambiguous network for key %q: found %d networks", key, len(resources))
+	}
+
+	ref, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: testcontainers.ContainerRequest{
+			Image:    sharedRefImage,
+			Cmd:      []string{"sleep", "infinity"},
+			Networks: []string{nw.Name},
+			Labels:   map[string]string{sharedKeyLabel: key},
+		},
+		Started: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create reference container: %w", err)
+	}
+
+	return &SharedNetwork{Network: nw, ref: ref}, nil
+}
+
+// raceResolutionAttempts is the number of times resolveRace polls NetworkList
+// for competing networks before declaring a winner.
+const raceResolutionAttempts = 5
+
+// raceResolutionInterval is how long resolveRace waits between polls, giving
+// a concurrently-created network time to propagate before it is re-listed.
+const raceResolutionInterval = 200 * time.Millisecond
+
+// resolveRace handles two Shared calls for the same key racing each other: if
+// both see no existing network and each create one, this keeps the network
+// with the lexicographically smallest ID as the winner, and removes the one
+// created by this call if it lost.
+//
+// A single NetworkList call right after creation isn't enough: if another
+// racing creator's network hasn't propagated to the daemon's list yet, this
+// call would only see its own network, declare itself the winner, and never
+// revisit that decision, leaving both networks live. Polling several times
+// gives a same-daemon-but-different-process creator a chance to show up
+// before a winner is picked.
+func resolveRace(ctx context.Context, cli *dockerclient.Client, key string, created *testcontainers.DockerNetwork) (*testcontainers.DockerNetwork, error) {
+	seen := map[string]string{created.ID: created.Name}
+
+	for attempt := 0; attempt < raceResolutionAttempts; attempt++ {
+		if attempt > 0 {
+			time.Sleep(raceResolutionInterval)
+		}
+
+		resources, err := cli.NetworkList(ctx, dockernetwork.ListOptions{
+			Filters: filters.NewArgs(filters.Arg("label", sharedKeyLabel+"="+key)),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list networks: %w", err)
+		}
+
+		for _, r := range resources {
+			seen[r.ID] = r.Name
+		}
+	}
+
+	if len(seen) <= 1 {
+		return created, nil
+	}
+
+	winnerID := created.ID
+	for id := range seen {
+		if id < winnerID {
+			winnerID = id
+		}
+	}
+
+	if winnerID == created.ID {
+		return created, nil
+	}
+
+	if err := cli.NetworkRemove(ctx, created.ID); err != nil {
+		return nil, fmt.Errorf("remove losing network %s: %w", created.ID, err)
+	}
+
+	return &testcontainers.DockerNetwork{ID: winnerID, Name: seen[winnerID]}, nil
+}
+
+// Release decrements sn's reference count, removing the underlying network
+// once the last reference has been released.
+func (sn *SharedNetwork) Release(ctx context.Context) error {
+	if err := sn.ref.Terminate(ctx); err != nil {
+		return fmt.Errorf("terminate reference container: %w", err)
+	}
+
+	cli, err := testcontainers.NewDockerClientWithOpts(ctx)
+	if err != nil {
+		return fmt.Errorf("new docker client: %w", err)
+	}
+	defer cli.Close()
+
+	// Include stopped containers: a crashed sentinel is still an attached
+	// endpoint as far as the network is concerned, and must keep it alive.
+	inUse, err := cli.ContainerList(ctx, container.ListOptions{
+		All:     true,
+		Filters: filters.NewArgs(filters.Arg("network", sn.Network.Name)),
+	})
+	if err != nil {
+		return fmt.Errorf("list containers attached to network: %w", err)
+	}
+
+	if len(inUse) > 0 {
+		return nil
+	}
+
+	return cli.NetworkRemove(ctx, sn.Network.ID)
+}
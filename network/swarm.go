@@ -0,0 +1,89 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/docker/docker/api/types/swarm"
+
+	"github.com/testcontainers/testcontainers-go"
+)
+
+// ErrSwarmUnsupported is returned by EnsureSwarm when the target daemon cannot
+// be put into swarm mode, e.g. because it is a Windows or rootless daemon.
+var ErrSwarmUnsupported = errors.New("network: daemon does not support swarm mode")
+
+// WithOverlayDriver configures the network to use the "overlay" driver and
+// makes it attachable, so that standalone containers, not just swarm services,
+// can join it. Creating an overlay network requires the target daemon to be
+// part of a swarm, see EnsureSwarm.
+func WithOverlayDriver() CustomizeNetworkOption {
+	return func(req *testcontainers.NetworkRequest) {
+		req.Driver = "overlay"
+		req.Attachable = true
+	}
+}
+
+// EnsureSwarm makes sure the target Docker daemon is running in swarm mode,
+// initialising a single-node swarm if one isn't already active. If EnsureSwarm
+// had to initialise the swarm, it registers a cleanup on tb that leaves it once
+// the test completes, restoring the daemon to its original state.
+func EnsureSwarm(ctx context.Context, tb testing.TB) error {
+	tb.Helper()
+
+	cli, err := testcontainers.NewDockerClientWithOpts(ctx)
+	if err != nil {
+		return fmt.Errorf("new docker client: %w", err)
+	}
+	defer cli.Close()
+
+	if _, err := cli.SwarmInspect(ctx); err == nil {
+		// a swarm is already active on this daemon, nothing to do
+		return nil
+	}
+
+	info, err := cli.Info(ctx)
+	if err != nil {
+		return fmt.Errorf("docker info: %w", err)
+	}
+
+	if info.OSType == "windows" {
+		return fmt.Errorf("%w: windows daemon", ErrSwarmUnsupported)
+	}
+
+	for _, opt := range info.SecurityOptions {
+		if strings.Contains(opt, "name=rootless") {
+			return fmt.Errorf("%w: rootless daemon", ErrSwarmUnsupported)
+		}
+	}
+
+	// AdvertiseAddr is left empty so the daemon resolves it from its own default
+	// route, instead of assuming a specific interface name like "eth0" that may
+	// not exist on the host.
+	// A SwarmInit failure here isn't necessarily ErrSwarmUnsupported: the two
+	// checks above already ruled out the known "daemon can't do swarm at all"
+	// cases, so whatever's left (port 2377 already bound, the node already
+	// being a worker in another swarm, a network blip) is a real, fixable
+	// error that callers using errors.Is(err, ErrSwarmUnsupported) to skip
+	// should not mistake for one.
+	if _, err := cli.SwarmInit(ctx, swarm.InitRequest{
+		ListenAddr: "0.0.0.0:2377",
+	}); err != nil {
+		return fmt.Errorf("swarm init: %w", err)
+	}
+
+	tb.Cleanup(func() {
+		leaveCli, err := testcontainers.NewDockerClientWithOpts(context.Background())
+		if err != nil {
+			return
+		}
+		defer leaveCli.Close()
+
+		_ = leaveCli.SwarmLeave(context.Background(), true)
+	})
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package testcontainers
+
+import (
+	"github.com/docker/docker/api/types/network"
+)
+
+// NetworkRequest describes how to create a Docker network via a Provider. Its
+// fields mirror Docker's own network.CreateOptions, plus the handful of
+// testcontainers-specific knob SkipReaper layered on top by the provider.
+type NetworkRequest struct {
+	Driver         string
+	CheckDuplicate bool
+	Internal       bool
+	EnableIPv6     *bool
+	Name           string
+	Labels         map[string]string
+	Attachable     bool
+	IPAM           *network.IPAM
+
+	// Ingress marks the network as the ingress network for a swarm. Only one
+	// ingress network can exist in a swarm at a time.
+	Ingress bool
+	// Scope sets the scope of the network, e.g. "local" or "swarm".
+	Scope string
+	// ConfigFrom names a config-only network to create this network's IPAM and
+	// options from. See ConfigOnly to create a config-only network.
+	ConfigFrom string
+	// ConfigOnly marks the network as a config-only network: one with no
+	// container endpoints, used only to share configuration via ConfigFrom.
+	ConfigOnly bool
+	// Options sets driver-specific options for the network, e.g.
+	// com.docker.network.bridge.name for the bridge driver.
+	Options map[string]string
+
+	SkipReaper bool
+}
+
+// createOptions translates the request into the options Docker's NetworkCreate
+// API expects, used by Provider.CreateNetwork.
+func (r NetworkRequest) createOptions() network.CreateOptions {
+	var configFrom *network.ConfigReference
+	if r.ConfigFrom != "" {
+		configFrom = &network.ConfigReference{Network: r.ConfigFrom}
+	}
+
+	return network.CreateOptions{
+		Driver:     r.Driver,
+		Internal:   r.Internal,
+		EnableIPv6: r.EnableIPv6,
+		Attachable: r.Attachable,
+		Ingress:    r.Ingress,
+		Scope:      r.Scope,
+		ConfigFrom: configFrom,
+		ConfigOnly: r.ConfigOnly,
+		Options:    r.Options,
+		IPAM:       r.IPAM,
+		Labels:     r.Labels,
+	}
+}